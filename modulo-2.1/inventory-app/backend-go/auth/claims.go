@@ -0,0 +1,45 @@
+// Package auth emite y valida los JWT que protegen las rutas de escritura
+// del inventario.
+package auth
+
+import "github.com/golang-jwt/jwt/v4"
+
+// Role es uno de los roles que puede llevar un token.
+type Role string
+
+const (
+	RoleReader Role = "reader"
+	RoleWriter Role = "writer"
+	RoleAdmin  Role = "admin"
+)
+
+// roleRank define la jerarquía de roles: un rol de rango mayor satisface
+// cualquier requisito de rango menor (admin puede todo lo que puede writer,
+// que puede todo lo que puede reader).
+var roleRank = map[Role]int{
+	RoleReader: 1,
+	RoleWriter: 2,
+	RoleAdmin:  3,
+}
+
+// Claims son los datos propios que viajan en el JWT, además de los
+// registrados estándar (exp, iat, sub, ...).
+type Claims struct {
+	Username string `json:"username"`
+	Roles    []Role `json:"roles"`
+	jwt.RegisteredClaims
+}
+
+// satisfies indica si Claims tiene al menos un rol cuyo rango alcanza min.
+func (c Claims) satisfies(min Role) bool {
+	minRank, ok := roleRank[min]
+	if !ok {
+		return false
+	}
+	for _, role := range c.Roles {
+		if roleRank[role] >= minRank {
+			return true
+		}
+	}
+	return false
+}
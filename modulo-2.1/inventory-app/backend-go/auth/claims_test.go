@@ -0,0 +1,30 @@
+package auth
+
+import "testing"
+
+func TestClaimsSatisfies(t *testing.T) {
+	tests := []struct {
+		name  string
+		roles []Role
+		min   Role
+		want  bool
+	}{
+		{"reader satisface reader", []Role{RoleReader}, RoleReader, true},
+		{"reader no satisface writer", []Role{RoleReader}, RoleWriter, false},
+		{"writer satisface reader", []Role{RoleWriter}, RoleReader, true},
+		{"admin satisface writer", []Role{RoleAdmin}, RoleWriter, true},
+		{"admin satisface admin", []Role{RoleAdmin}, RoleAdmin, true},
+		{"sin roles no satisface nada", nil, RoleReader, false},
+		{"uno de varios roles alcanza", []Role{RoleReader, RoleAdmin}, RoleWriter, true},
+		{"rol desconocido como mínimo nunca se satisface", []Role{RoleAdmin}, Role("superadmin"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims := Claims{Roles: tt.roles}
+			if got := claims.satisfies(tt.min); got != tt.want {
+				t.Errorf("satisfies(%v) con roles=%v = %v, se esperaba %v", tt.min, tt.roles, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package auth
+
+import (
+	"fmt"
+	"os"
+	"time"
+)
+
+// Config reúne los parámetros de auth leídos de variables de entorno.
+type Config struct {
+	Secret string
+	TTL    time.Duration
+}
+
+// LoadConfig lee AUTH_JWT_SECRET (obligatoria) y AUTH_TOKEN_TTL (opcional,
+// por defecto 15 minutos).
+func LoadConfig() (Config, error) {
+	secret := os.Getenv("AUTH_JWT_SECRET")
+	if secret == "" {
+		return Config{}, fmt.Errorf("auth: falta la variable de entorno AUTH_JWT_SECRET")
+	}
+
+	ttl := 15 * time.Minute
+	if raw := os.Getenv("AUTH_TOKEN_TTL"); raw != "" {
+		parsed, err := time.ParseDuration(raw)
+		if err != nil {
+			return Config{}, fmt.Errorf("auth: AUTH_TOKEN_TTL inválido: %w", err)
+		}
+		ttl = parsed
+	}
+
+	return Config{Secret: secret, TTL: ttl}, nil
+}
@@ -0,0 +1,61 @@
+package auth
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// ErrInvalidCredentials se devuelve cuando el usuario no existe o la
+// contraseña no coincide.
+var ErrInvalidCredentials = errors.New("auth: credenciales inválidas")
+
+// Credential es la entrada de un usuario en el archivo de configuración de
+// credenciales.
+type Credential struct {
+	Username     string `json:"username"`
+	PasswordHash string `json:"password_hash"`
+	Roles        []Role `json:"roles"`
+}
+
+// CredentialStore resuelve credenciales cargadas desde un archivo JSON.
+type CredentialStore struct {
+	byUsername map[string]Credential
+}
+
+// LoadCredentials lee un archivo JSON con forma `{"users": [...]}`.
+func LoadCredentials(path string) (*CredentialStore, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("auth: error al leer %s: %w", path, err)
+	}
+
+	var parsed struct {
+		Users []Credential `json:"users"`
+	}
+	if err := json.Unmarshal(data, &parsed); err != nil {
+		return nil, fmt.Errorf("auth: error al parsear %s: %w", path, err)
+	}
+
+	byUsername := make(map[string]Credential, len(parsed.Users))
+	for _, cred := range parsed.Users {
+		byUsername[cred.Username] = cred
+	}
+	return &CredentialStore{byUsername: byUsername}, nil
+}
+
+// Authenticate valida username/password y devuelve la credencial (con sus
+// roles) si son correctos.
+func (s *CredentialStore) Authenticate(username, password string) (Credential, error) {
+	cred, ok := s.byUsername[username]
+	if !ok {
+		return Credential{}, ErrInvalidCredentials
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(cred.PasswordHash), []byte(password)); err != nil {
+		return Credential{}, ErrInvalidCredentials
+	}
+	return cred, nil
+}
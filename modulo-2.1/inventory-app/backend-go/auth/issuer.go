@@ -0,0 +1,77 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// ErrInvalidToken agrupa cualquier token ausente, mal formado, expirado o
+// firmado con otra clave.
+var ErrInvalidToken = errors.New("auth: token inválido")
+
+// Issuer firma y valida los JWT de la API con una clave simétrica y un TTL
+// fijo, ambos configurados vía variables de entorno (ver Config).
+type Issuer struct {
+	secret []byte
+	ttl    time.Duration
+}
+
+// NewIssuer crea un Issuer. secret no debe estar vacío.
+func NewIssuer(secret string, ttl time.Duration) (*Issuer, error) {
+	if secret == "" {
+		return nil, errors.New("auth: el secreto JWT no puede estar vacío")
+	}
+	if ttl <= 0 {
+		return nil, errors.New("auth: el TTL del token debe ser positivo")
+	}
+	return &Issuer{secret: []byte(secret), ttl: ttl}, nil
+}
+
+// Issue genera un token firmado para username con los roles dados.
+func (i *Issuer) Issue(username string, roles []Role) (string, error) {
+	now := time.Now()
+	claims := Claims{
+		Username: username,
+		Roles:    roles,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   username,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(i.ttl)),
+		},
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	signed, err := token.SignedString(i.secret)
+	if err != nil {
+		return "", fmt.Errorf("auth: error al firmar el token: %w", err)
+	}
+	return signed, nil
+}
+
+// Parse valida tokenString y devuelve sus claims.
+func (i *Issuer) Parse(tokenString string) (*Claims, error) {
+	var claims Claims
+	token, err := jwt.ParseWithClaims(tokenString, &claims, func(t *jwt.Token) (interface{}, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("auth: método de firma inesperado: %v", t.Header["alg"])
+		}
+		return i.secret, nil
+	})
+	if err != nil || !token.Valid {
+		return nil, ErrInvalidToken
+	}
+	return &claims, nil
+}
+
+// Refresh valida tokenString y emite un nuevo token con el mismo usuario y
+// roles, pero un TTL renovado.
+func (i *Issuer) Refresh(tokenString string) (string, error) {
+	claims, err := i.Parse(tokenString)
+	if err != nil {
+		return "", err
+	}
+	return i.Issue(claims.Username, claims.Roles)
+}
@@ -0,0 +1,57 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+	"strings"
+)
+
+type contextKey string
+
+const claimsContextKey contextKey = "auth.claims"
+
+// ClaimsFromContext devuelve las claims adjuntadas por RequireRole, si las
+// hay.
+func ClaimsFromContext(ctx context.Context) (*Claims, bool) {
+	claims, ok := ctx.Value(claimsContextKey).(*Claims)
+	return claims, ok
+}
+
+// RequireRole devuelve un middleware que exige un Bearer token válido cuyo
+// rol alcance al menos min, y adjunta las Claims al contexto de la
+// petición.
+func RequireRole(issuer *Issuer, min Role) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			tokenString := BearerToken(r)
+			if tokenString == "" {
+				http.Error(w, "Falta el token de autorización", http.StatusUnauthorized)
+				return
+			}
+
+			claims, err := issuer.Parse(tokenString)
+			if err != nil {
+				http.Error(w, "Token inválido o expirado", http.StatusUnauthorized)
+				return
+			}
+
+			if !claims.satisfies(min) {
+				http.Error(w, "El token no tiene permisos suficientes", http.StatusForbidden)
+				return
+			}
+
+			ctx := context.WithValue(r.Context(), claimsContextKey, claims)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// BearerToken extrae el token del header `Authorization: Bearer <token>`.
+func BearerToken(r *http.Request) string {
+	header := r.Header.Get("Authorization")
+	const prefix = "Bearer "
+	if !strings.HasPrefix(header, prefix) {
+		return ""
+	}
+	return strings.TrimPrefix(header, prefix)
+}
@@ -0,0 +1,106 @@
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func newTestIssuer(t *testing.T) *Issuer {
+	t.Helper()
+	issuer, err := NewIssuer("test-secret", time.Minute)
+	if err != nil {
+		t.Fatalf("NewIssuer: %v", err)
+	}
+	return issuer
+}
+
+func TestRequireRoleRejectsMissingToken(t *testing.T) {
+	issuer := newTestIssuer(t)
+	handler := RequireRole(issuer, RoleReader)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("el handler protegido no debería ejecutarse sin token")
+	}))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	if rec.Code != http.StatusUnauthorized {
+		t.Fatalf("status = %d, se esperaba %d", rec.Code, http.StatusUnauthorized)
+	}
+}
+
+func TestRequireRoleRejectsInsufficientRole(t *testing.T) {
+	issuer := newTestIssuer(t)
+	token, err := issuer.Issue("alice", []Role{RoleReader})
+	if err != nil {
+		t.Fatalf("Issue: %v", err)
+	}
+
+	handler := RequireRole(issuer, RoleWriter)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("el handler protegido no debería ejecutarse sin el rol requerido")
+	}))
+
+	req := httptest.NewRequest(http.MethodPost, "/", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusForbidden {
+		t.Fatalf("status = %d, se esperaba %d", rec.Code, http.StatusForbidden)
+	}
+}
+
+func TestRequireRoleAllowsSufficientRoleAndAttachesClaims(t *testing.T) {
+	issuer := newTestIssuer(t)
+	token, err := issuer.Issue("alice", []Role{RoleWriter})
+	if err != nil {
+		t.Fatalf("Issue: %v", err)
+	}
+
+	var gotUsername string
+	handler := RequireRole(issuer, RoleWriter)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		claims, ok := ClaimsFromContext(r.Context())
+		if !ok {
+			t.Fatal("se esperaban claims en el contexto")
+		}
+		gotUsername = claims.Username
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	req := httptest.NewRequest(http.MethodPost, "/", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, se esperaba %d", rec.Code, http.StatusOK)
+	}
+	if gotUsername != "alice" {
+		t.Fatalf("username de las claims = %q, se esperaba %q", gotUsername, "alice")
+	}
+}
+
+func TestBearerToken(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+		want   string
+	}{
+		{"con prefijo Bearer", "Bearer abc.def.ghi", "abc.def.ghi"},
+		{"sin header", "", ""},
+		{"sin prefijo Bearer", "abc.def.ghi", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.header != "" {
+				req.Header.Set("Authorization", tt.header)
+			}
+			if got := BearerToken(req); got != tt.want {
+				t.Errorf("BearerToken() = %q, se esperaba %q", got, tt.want)
+			}
+		})
+	}
+}
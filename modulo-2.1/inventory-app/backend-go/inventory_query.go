@@ -0,0 +1,134 @@
+package main
+
+import (
+	"fmt"
+	"hash/fnv"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+
+	"github.com/cTapiaDev/ai-programming-course/modulo-2.1/inventory-app/backend-go/store"
+)
+
+// defaultPageSize y maxPageSize acotan ?page_size= para GET /api/inventory.
+const (
+	defaultPageSize = 20
+	maxPageSize     = 200
+)
+
+// inventoryPage es el cuerpo devuelto por GET /api/inventory.
+type inventoryPage struct {
+	Items    []store.InventoryItem `json:"items"`
+	Total    int                   `json:"total"`
+	Page     int                   `json:"page"`
+	PageSize int                   `json:"page_size"`
+}
+
+// parseInventoryQuery traduce los parámetros de consulta de GET
+// /api/inventory en un store.Filter más el orden y la paginación pedidos.
+func parseInventoryQuery(values url.Values) (filter store.Filter, sortBy string, page, pageSize int, err error) {
+	filter = store.Filter{
+		Category: values.Get("category"),
+		SKU:      values.Get("sku"),
+		Query:    values.Get("q"),
+	}
+
+	if raw := values.Get("min_stock"); raw != "" {
+		n, convErr := strconv.Atoi(raw)
+		if convErr != nil {
+			return store.Filter{}, "", 0, 0, fmt.Errorf("min_stock inválido: %q", raw)
+		}
+		filter.MinStock = &n
+	}
+	if raw := values.Get("max_price"); raw != "" {
+		f, convErr := strconv.ParseFloat(raw, 64)
+		if convErr != nil {
+			return store.Filter{}, "", 0, 0, fmt.Errorf("max_price inválido: %q", raw)
+		}
+		filter.MaxPrice = &f
+	}
+
+	sortBy = values.Get("sort")
+	switch sortBy {
+	case "", "price", "stock", "name":
+	default:
+		return store.Filter{}, "", 0, 0, fmt.Errorf("sort inválido: %q", sortBy)
+	}
+
+	page = 1
+	if raw := values.Get("page"); raw != "" {
+		n, convErr := strconv.Atoi(raw)
+		if convErr != nil || n < 1 {
+			return store.Filter{}, "", 0, 0, fmt.Errorf("page inválido: %q", raw)
+		}
+		page = n
+	}
+
+	pageSize = defaultPageSize
+	if raw := values.Get("page_size"); raw != "" {
+		n, convErr := strconv.Atoi(raw)
+		if convErr != nil || n < 1 {
+			return store.Filter{}, "", 0, 0, fmt.Errorf("page_size inválido: %q", raw)
+		}
+		if n > maxPageSize {
+			n = maxPageSize
+		}
+		pageSize = n
+	}
+
+	return filter, sortBy, page, pageSize, nil
+}
+
+// sortInventory ordena items in-place. Sin ?sort=, el orden por defecto es
+// por ID, para que la paginación sea estable entre peticiones.
+func sortInventory(items []store.InventoryItem, sortBy string) {
+	switch sortBy {
+	case "price":
+		sort.Slice(items, func(i, j int) bool { return items[i].Price < items[j].Price })
+	case "stock":
+		sort.Slice(items, func(i, j int) bool { return items[i].Stock < items[j].Stock })
+	case "name":
+		sort.Slice(items, func(i, j int) bool { return items[i].ProductName < items[j].ProductName })
+	default:
+		sort.Slice(items, func(i, j int) bool { return items[i].ID < items[j].ID })
+	}
+}
+
+// paginate devuelve los índices [start:end) de items correspondientes a
+// page/pageSize, acotados a [0, total].
+func paginate(total, page, pageSize int) (start, end int) {
+	start = (page - 1) * pageSize
+	if start > total {
+		start = total
+	}
+	end = start + pageSize
+	if end > total {
+		end = total
+	}
+	return start, end
+}
+
+// inventoryETag calcula un ETag débil a partir de la versión del inventario
+// y la query string, sin tener que serializar ni recorrer el inventario.
+func inventoryETag(version uint64, rawQuery string) string {
+	h := fnv.New64a()
+	fmt.Fprintf(h, "%d:%s", version, rawQuery)
+	return fmt.Sprintf(`W/"%x"`, h.Sum64())
+}
+
+// applyInventoryCaching establece ETag y Cache-Control para GET
+// /api/inventory cuando el backend activo lleva versión, y devuelve true si
+// la petición ya puede responderse con 304 Not Modified.
+func applyInventoryCaching(w http.ResponseWriter, r *http.Request) bool {
+	versioned, ok := inventoryStore.(store.Versioned)
+	if !ok {
+		return false
+	}
+
+	etag := inventoryETag(versioned.Version(), r.URL.RawQuery)
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Cache-Control", fmt.Sprintf("max-age=%d", cacheMaxAge))
+
+	return r.Header.Get("If-None-Match") == etag
+}
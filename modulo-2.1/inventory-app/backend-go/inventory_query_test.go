@@ -0,0 +1,28 @@
+package main
+
+import "testing"
+
+func TestPaginate(t *testing.T) {
+	tests := []struct {
+		name               string
+		total, page, size  int
+		wantStart, wantEnd int
+	}{
+		{"primera página completa", 50, 1, 20, 0, 20},
+		{"página intermedia", 50, 2, 20, 20, 40},
+		{"última página parcial", 50, 3, 20, 40, 50},
+		{"página más allá del total", 50, 10, 20, 50, 50},
+		{"total vacío", 0, 1, 20, 0, 0},
+		{"page_size mayor que el total", 5, 1, 20, 0, 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end := paginate(tt.total, tt.page, tt.size)
+			if start != tt.wantStart || end != tt.wantEnd {
+				t.Errorf("paginate(%d, %d, %d) = (%d, %d), se esperaba (%d, %d)",
+					tt.total, tt.page, tt.size, start, end, tt.wantStart, tt.wantEnd)
+			}
+		})
+	}
+}
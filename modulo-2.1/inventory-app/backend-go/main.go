@@ -1,212 +1,319 @@
 package main
 
 import (
-	"encoding/csv"
+	"context"
 	"encoding/json"
-	"fmt"
-	"io"
+	"errors"
+	"flag"
 	"log"
+	"log/slog"
 	"net/http"
 	"os"
-	"sync"
 
-	"github.com/gocarina/gocsv"
 	"github.com/gorilla/mux"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/rs/cors"
+
+	"github.com/cTapiaDev/ai-programming-course/modulo-2.1/inventory-app/backend-go/auth"
+	"github.com/cTapiaDev/ai-programming-course/modulo-2.1/inventory-app/backend-go/metrics"
+	"github.com/cTapiaDev/ai-programming-course/modulo-2.1/inventory-app/backend-go/store"
+	"github.com/cTapiaDev/ai-programming-course/modulo-2.1/inventory-app/backend-go/watcher"
 )
 
-// InventoryItem representa un item del inventario
-type InventoryItem struct {
-	ID          string  `csv:"id" json:"id"`
-	SKU         string  `csv:"sku" json:"sku"`
-	ProductName string  `csv:"product_name" json:"product_name"`
-	Category    string  `csv:"category" json:"category"`
-	Stock       int     `csv:"stock" json:"stock"`
-	Price       float64 `csv:"price" json:"price"`
-	LastUpdated string  `csv:"last_updated" json:"last_updated"`
-}
+// defaultStoreBackend, defaultStorePath y defaultUsersFile se usan cuando no
+// se configura nada por flag ni por variable de entorno.
+const (
+	defaultStoreBackend = "csv"
+	defaultStorePath    = "inventory.csv"
+	defaultUsersFile    = "users.json"
+	defaultCacheMaxAge  = 30
+)
+
+// version, commit y builtAt se inyectan en tiempo de build con
+// -ldflags "-X main.version=... -X main.commit=... -X main.builtAt=...".
+// Sin inyección quedan en "dev"/"none"/"unknown".
+var (
+	version = "dev"
+	commit  = "none"
+	builtAt = "unknown"
+)
+
+// inventoryStore contiene el backend de persistencia activo.
+var inventoryStore store.InventoryStore
+
+// issuer emite y valida los JWT de la API.
+var issuer *auth.Issuer
 
-// Variable global que contendrá todo el inventario
-var inventory []InventoryItem
-var inventoryMutex sync.RWMutex
+// credentials resuelve usuario/contraseña contra el archivo de usuarios.
+var credentials *auth.CredentialStore
 
-// loadInventoryParallel carga el archivo CSV usando goroutines para procesamiento paralelo
-func loadInventoryParallel(filename string) error {
-	// Abrir el archivo
-	file, err := os.Open(filename)
+// cacheMaxAge es el max-age (en segundos) anunciado en Cache-Control para
+// GET /api/inventory.
+var cacheMaxAge int
+
+// getInventoryHandler atiende GET /api/inventory con filtro, orden,
+// paginación y ETag.
+func getInventoryHandler(w http.ResponseWriter, r *http.Request) {
+	filter, sortBy, page, pageSize, err := parseInventoryQuery(r.URL.Query())
 	if err != nil {
-		return fmt.Errorf("error al abrir el archivo: %w", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
 	}
-	defer file.Close()
 
-	// Leer el archivo CSV
-	csvReader := csv.NewReader(file)
+	if applyInventoryCaching(w, r) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
 
-	// Leer la cabecera primero
-	header, err := csvReader.Read()
+	items, err := inventoryStore.List(r.Context(), filter)
 	if err != nil {
-		return fmt.Errorf("error al leer la cabecera: %w", err)
-	}
-
-	// Canales para procesamiento paralelo
-	type rowData struct {
-		index int
-		row   []string
-		err   error
-	}
-
-	rowChannel := make(chan rowData, 100)
-	resultChannel := make(chan InventoryItem, 100)
-	errorChannel := make(chan error, 1)
-
-	// WaitGroup para las goroutines de procesamiento
-	var wg sync.WaitGroup
-
-	// Número de workers para procesamiento paralelo
-	numWorkers := 4
-
-	// Iniciar workers que convierten filas en structs
-	for i := 0; i < numWorkers; i++ {
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
-			for row := range rowChannel {
-				if row.err != nil {
-					select {
-					case errorChannel <- row.err:
-					default:
-					}
-					return
-				}
-
-				// Usar gocsv para parsear la fila individual
-				// Creamos un reader temporal con la cabecera y la fila actual
-				csvData := [][]string{header, row.row}
-				csvString := ""
-				for _, record := range csvData {
-					for j, field := range record {
-						if j > 0 {
-							csvString += ","
-						}
-						csvString += field
-					}
-					csvString += "\n"
-				}
-
-				// Parsear usando gocsv
-				var items []InventoryItem
-				err := gocsv.UnmarshalString(csvString, &items)
-				if err != nil {
-					select {
-					case errorChannel <- fmt.Errorf("error al parsear fila %d: %w", row.index, err):
-					default:
-					}
-					return
-				}
-
-				if len(items) > 0 {
-					resultChannel <- items[0]
-				}
-			}
-		}()
-	}
-
-	// Goroutine para recolectar resultados
-	var results []InventoryItem
-	var resultWg sync.WaitGroup
-	resultWg.Add(1)
-	go func() {
-		defer resultWg.Done()
-		for item := range resultChannel {
-			results = append(results, item)
-		}
-	}()
-
-	// Leer todas las filas y enviarlas al canal
-	rowIndex := 0
-	for {
-		row, err := csvReader.Read()
-		if err == io.EOF {
-			break
-		}
-		rowIndex++
+		http.Error(w, "Error al listar el inventario", http.StatusInternalServerError)
+		log.Printf("Error al listar el inventario: %v\n", err)
+		return
+	}
+	sortInventory(items, sortBy)
 
-		rowChannel <- rowData{
-			index: rowIndex,
-			row:   row,
-			err:   err,
-		}
+	total := len(items)
+	start, end := paginate(total, page, pageSize)
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(inventoryPage{
+		Items:    items[start:end],
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	})
+	if err != nil {
+		http.Error(w, "Error al codificar respuesta JSON", http.StatusInternalServerError)
+		log.Printf("Error al codificar JSON: %v\n", err)
+		return
 	}
+}
 
-	// Cerrar el canal de filas y esperar a que los workers terminen
-	close(rowChannel)
-	wg.Wait()
+// upsertInventoryHandler atiende POST y PUT /api/inventory/{id}.
+func upsertInventoryHandler(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)["id"]
 
-	// Cerrar el canal de resultados y esperar a que se recolecten todos
-	close(resultChannel)
-	resultWg.Wait()
+	var item store.InventoryItem
+	if err := json.NewDecoder(r.Body).Decode(&item); err != nil {
+		http.Error(w, "Cuerpo de la petición inválido", http.StatusBadRequest)
+		return
+	}
+	item.ID = id
+
+	if err := inventoryStore.Upsert(r.Context(), item); err != nil {
+		http.Error(w, "Error al guardar el item", http.StatusInternalServerError)
+		log.Printf("Error al guardar el item %q: %v\n", id, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(item)
+}
+
+// deleteInventoryHandler atiende DELETE /api/inventory/{id}.
+func deleteInventoryHandler(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)["id"]
 
-	// Verificar si hubo errores
-	select {
-	case err := <-errorChannel:
-		return err
-	default:
+	err := inventoryStore.Delete(r.Context(), id)
+	if errors.Is(err, store.ErrNotFound) {
+		http.Error(w, "Item no encontrado", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		http.Error(w, "Error al eliminar el item", http.StatusInternalServerError)
+		log.Printf("Error al eliminar el item %q: %v\n", id, err)
+		return
 	}
 
-	// Actualizar la variable global de forma segura
-	inventoryMutex.Lock()
-	inventory = results
-	inventoryMutex.Unlock()
+	w.WriteHeader(http.StatusNoContent)
+}
 
-	log.Printf("Inventario cargado exitosamente: %d items\n", len(results))
-	return nil
+// loginRequest es el cuerpo esperado por POST /auth/login.
+type loginRequest struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
 }
 
-// Handler para GET /api/inventory
-func getInventoryHandler(w http.ResponseWriter, r *http.Request) {
-	// Bloquear para lectura
-	inventoryMutex.RLock()
-	defer inventoryMutex.RUnlock()
+// tokenResponse es el cuerpo devuelto por /auth/login y /auth/refresh.
+type tokenResponse struct {
+	Token string `json:"token"`
+}
+
+// loginHandler atiende POST /auth/login.
+func loginHandler(w http.ResponseWriter, r *http.Request) {
+	var req loginRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Cuerpo de la petición inválido", http.StatusBadRequest)
+		return
+	}
+
+	cred, err := credentials.Authenticate(req.Username, req.Password)
+	if err != nil {
+		http.Error(w, "Usuario o contraseña incorrectos", http.StatusUnauthorized)
+		return
+	}
+
+	token, err := issuer.Issue(cred.Username, cred.Roles)
+	if err != nil {
+		http.Error(w, "Error al emitir el token", http.StatusInternalServerError)
+		log.Printf("Error al emitir el token para %q: %v\n", cred.Username, err)
+		return
+	}
 
-	// Establecer cabecera de respuesta
 	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(tokenResponse{Token: token})
+}
+
+// refreshHandler atiende POST /auth/refresh.
+func refreshHandler(w http.ResponseWriter, r *http.Request) {
+	tokenString := auth.BearerToken(r)
+	if tokenString == "" {
+		http.Error(w, "Falta el token de autorización", http.StatusUnauthorized)
+		return
+	}
 
-	// Codificar el inventario como JSON
-	err := json.NewEncoder(w).Encode(inventory)
+	newToken, err := issuer.Refresh(tokenString)
 	if err != nil {
-		http.Error(w, "Error al codificar respuesta JSON", http.StatusInternalServerError)
-		log.Printf("Error al codificar JSON: %v\n", err)
+		http.Error(w, "Token inválido o expirado", http.StatusUnauthorized)
 		return
 	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(tokenResponse{Token: newToken})
 }
 
-// Handler para verificar el estado del servidor
+// adminReloadHandler atiende POST /admin/reload. Dispara la misma recarga
+// que el watcher de archivo, así que lo comparten.
+func adminReloadHandler(w http.ResponseWriter, r *http.Request) {
+	reloadable, ok := inventoryStore.(store.Reloadable)
+	if !ok {
+		http.Error(w, "El backend de inventario activo no admite recarga", http.StatusNotImplemented)
+		return
+	}
+
+	summary, err := reloadable.Reload(r.Context())
+	metrics.RecordReload(float64(summary.DurationMS)/1000, summary.Loaded, err)
+	if err != nil {
+		http.Error(w, "Error al recargar el inventario", http.StatusInternalServerError)
+		log.Printf("Error al recargar el inventario: %v\n", err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(summary)
+}
+
+// healthCheckHandler verifica el estado del servidor.
 func healthCheckHandler(w http.ResponseWriter, r *http.Request) {
-	inventoryMutex.RLock()
-	count := len(inventory)
-	inventoryMutex.RUnlock()
+	items, err := inventoryStore.List(r.Context(), store.Filter{})
+	if err != nil {
+		http.Error(w, "Error al leer el inventario", http.StatusInternalServerError)
+		return
+	}
 
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(map[string]interface{}{
-		"status": "ok",
-		"items":  count,
+		"status":   "ok",
+		"items":    len(items),
+		"version":  version,
+		"commit":   commit,
+		"built_at": builtAt,
 	})
 }
 
+// config reúne la configuración del servidor resuelta a partir de flags y
+// variables de entorno. Las variables de entorno ganan sobre el valor por
+// defecto del flag pero no sobre un flag explícito.
+type config struct {
+	storeBackend string
+	storePath    string
+	usersFile    string
+	cacheMaxAge  int
+}
+
+func loadConfig() config {
+	cfg := config{
+		storeBackend: defaultStoreBackend,
+		storePath:    defaultStorePath,
+		usersFile:    defaultUsersFile,
+		cacheMaxAge:  defaultCacheMaxAge,
+	}
+	if v := os.Getenv("INVENTORY_STORE"); v != "" {
+		cfg.storeBackend = v
+	}
+	if v := os.Getenv("AUTH_USERS_FILE"); v != "" {
+		cfg.usersFile = v
+	}
+
+	flag.StringVar(&cfg.storeBackend, "store", cfg.storeBackend, "backend de inventario: csv, jsonl o kv")
+	flag.StringVar(&cfg.storePath, "store-path", cfg.storePath, "ruta del archivo/base de datos de inventario")
+	flag.StringVar(&cfg.usersFile, "users-file", cfg.usersFile, "ruta del archivo JSON de credenciales")
+	flag.IntVar(&cfg.cacheMaxAge, "cache-max-age", cfg.cacheMaxAge, "max-age (segundos) de Cache-Control en GET /api/inventory")
+	flag.Parse()
+
+	return cfg
+}
+
 func main() {
-	// Cargar el inventario desde el archivo CSV
-	log.Println("Cargando inventario desde inventory.csv...")
-	err := loadInventoryParallel("inventory.csv")
+	slog.SetDefault(slog.New(slog.NewJSONHandler(os.Stdout, nil)))
+	metrics.SetBuildInfo(version, commit, builtAt)
+
+	cfg := loadConfig()
+	cacheMaxAge = cfg.cacheMaxAge
+
+	log.Printf("Cargando inventario (%s) desde %s...\n", cfg.storeBackend, cfg.storePath)
+	s, err := store.New(cfg.storeBackend, cfg.storePath)
 	if err != nil {
 		log.Fatalf("Error fatal al cargar el inventario: %v\n", err)
 	}
+	inventoryStore = s
+	defer inventoryStore.Close()
+
+	if items, err := inventoryStore.List(context.Background(), store.Filter{}); err == nil {
+		metrics.SetItemsTotal(len(items))
+	}
+
+	authCfg, err := auth.LoadConfig()
+	if err != nil {
+		log.Fatalf("Error fatal de configuración de auth: %v\n", err)
+	}
+	issuer, err = auth.NewIssuer(authCfg.Secret, authCfg.TTL)
+	if err != nil {
+		log.Fatalf("Error fatal al crear el emisor de tokens: %v\n", err)
+	}
+
+	log.Printf("Cargando credenciales desde %s...\n", cfg.usersFile)
+	credentials, err = auth.LoadCredentials(cfg.usersFile)
+	if err != nil {
+		log.Fatalf("Error fatal al cargar las credenciales: %v\n", err)
+	}
+
+	if reloadable, ok := inventoryStore.(store.Reloadable); ok {
+		w, err := watcher.New(cfg.storePath, reloadable)
+		if err != nil {
+			log.Printf("No se pudo iniciar el watcher de %s: %v\n", cfg.storePath, err)
+		} else {
+			go w.Run(context.Background())
+		}
+	}
 
 	// Crear el router
 	router := mux.NewRouter()
 
+	requireReader := auth.RequireRole(issuer, auth.RoleReader)
+	requireWriter := auth.RequireRole(issuer, auth.RoleWriter)
+	requireAdmin := auth.RequireRole(issuer, auth.RoleAdmin)
+
 	// Definir las rutas
-	router.HandleFunc("/api/inventory", getInventoryHandler).Methods("GET")
+	router.Handle("/api/inventory", requireReader(http.HandlerFunc(getInventoryHandler))).Methods("GET")
+	router.Handle("/api/inventory/{id}", requireWriter(http.HandlerFunc(upsertInventoryHandler))).Methods("POST", "PUT")
+	router.Handle("/api/inventory/{id}", requireWriter(http.HandlerFunc(deleteInventoryHandler))).Methods("DELETE")
+	router.Handle("/admin/reload", requireAdmin(http.HandlerFunc(adminReloadHandler))).Methods("POST")
+	router.HandleFunc("/auth/login", loginHandler).Methods("POST")
+	router.HandleFunc("/auth/refresh", refreshHandler).Methods("POST")
 	router.HandleFunc("/health", healthCheckHandler).Methods("GET")
+	router.Handle("/metrics", promhttp.Handler()).Methods("GET")
 
 	// Configurar CORS usando rs/cors
 	corsHandler := cors.New(cors.Options{
@@ -216,8 +323,9 @@ func main() {
 		AllowCredentials: true,
 	})
 
-	// Aplicar el middleware de CORS
-	handler := corsHandler.Handler(router)
+	// Aplicar el middleware de CORS, envuelto por el de métricas/logging para
+	// que este último también cubra las peticiones que CORS rechaza.
+	handler := metrics.Middleware(router)(corsHandler.Handler(router))
 
 	// Iniciar el servidor
 	port := "8080"
@@ -0,0 +1,81 @@
+// Package metrics expone las métricas Prometheus del servicio y el
+// middleware HTTP que las alimenta.
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	// ItemsTotal es el tamaño actual del inventario en memoria.
+	ItemsTotal = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "inventory_items_total",
+		Help: "Número de items en el inventario cargado en memoria.",
+	})
+
+	// ReloadDuration mide cuánto tarda cada recarga del inventario, exitosa
+	// o no.
+	ReloadDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "inventory_reload_duration_seconds",
+		Help:    "Duración de las recargas del inventario.",
+		Buckets: prometheus.DefBuckets,
+	})
+
+	// ReloadErrors cuenta las recargas que terminaron en error.
+	ReloadErrors = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "inventory_reload_errors_total",
+		Help: "Número de recargas del inventario que fallaron.",
+	})
+
+	// HTTPRequestsTotal cuenta peticiones HTTP por ruta, método y status.
+	HTTPRequestsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "http_requests_total",
+		Help: "Número de peticiones HTTP atendidas.",
+	}, []string{"route", "method", "status"})
+
+	// HTTPRequestDuration mide la duración de las peticiones HTTP por ruta.
+	HTTPRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "http_request_duration_seconds",
+		Help:    "Duración de las peticiones HTTP.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"route"})
+
+	// BuildInfo expone version/commit/built_at como etiquetas con valor 1,
+	// el patrón habitual de Prometheus para información de build.
+	BuildInfo = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "build_info",
+		Help: "Metadatos de build del binario en ejecución (valor siempre 1).",
+	}, []string{"version", "commit", "built_at"})
+)
+
+func init() {
+	prometheus.MustRegister(
+		ItemsTotal,
+		ReloadDuration,
+		ReloadErrors,
+		HTTPRequestsTotal,
+		HTTPRequestDuration,
+		BuildInfo,
+	)
+}
+
+// SetItemsTotal actualiza el gauge de tamaño del inventario.
+func SetItemsTotal(n int) {
+	ItemsTotal.Set(float64(n))
+}
+
+// RecordReload registra una recarga del inventario, exitosa o no.
+func RecordReload(seconds float64, loaded int, err error) {
+	ReloadDuration.Observe(seconds)
+	if err != nil {
+		ReloadErrors.Inc()
+		return
+	}
+	SetItemsTotal(loaded)
+}
+
+// SetBuildInfo publica la versión, commit y fecha de build del binario.
+func SetBuildInfo(version, commit, builtAt string) {
+	BuildInfo.Reset()
+	BuildInfo.WithLabelValues(version, commit, builtAt).Set(1)
+}
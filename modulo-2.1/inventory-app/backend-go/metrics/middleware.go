@@ -0,0 +1,103 @@
+package metrics
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+)
+
+// requestIDHeader es el header en el que se lee y se devuelve el
+// identificador de la petición.
+const requestIDHeader = "X-Request-Id"
+
+// unmatchedRoute etiqueta las peticiones que no casan con ninguna ruta
+// declarada (404, método no permitido, etc), para no exponer el path crudo
+// como label de Prometheus.
+const unmatchedRoute = "unmatched"
+
+// Middleware registra métricas y un log JSON estructurado por petición. Debe
+// aplicarse antes que CORS para cubrir también las peticiones que CORS
+// rechaza. router se usa únicamente para resolver la plantilla de ruta
+// (p.ej. "/api/inventory/{id}") que etiqueta las métricas, así que un ID de
+// item distinto no abre una serie de Prometheus nueva por valor.
+func Middleware(router *mux.Router) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requestID := r.Header.Get(requestIDHeader)
+			if requestID == "" {
+				requestID = newRequestID()
+			}
+			w.Header().Set(requestIDHeader, requestID)
+
+			route := routeTemplate(router, r)
+
+			sw := &statusWriter{ResponseWriter: w, status: http.StatusOK}
+			start := time.Now()
+
+			next.ServeHTTP(sw, r)
+
+			duration := time.Since(start)
+			status := strconv.Itoa(sw.status)
+
+			HTTPRequestsTotal.WithLabelValues(route, r.Method, status).Inc()
+			HTTPRequestDuration.WithLabelValues(route).Observe(duration.Seconds())
+
+			slog.Info("http_request",
+				"request_id", requestID,
+				"method", r.Method,
+				"route", route,
+				"path", r.URL.Path,
+				"status", sw.status,
+				"bytes", sw.bytes,
+				"duration_ms", duration.Milliseconds(),
+			)
+		})
+	}
+}
+
+// routeTemplate resuelve la plantilla de la ruta de mux que matchea r, sin
+// despachar la petición. Si ninguna ruta declarada matchea, devuelve
+// unmatchedRoute en lugar del path crudo.
+func routeTemplate(router *mux.Router, r *http.Request) string {
+	var match mux.RouteMatch
+	if router.Match(r, &match) && match.Route != nil {
+		if tmpl, err := match.Route.GetPathTemplate(); err == nil {
+			return tmpl
+		}
+	}
+	return unmatchedRoute
+}
+
+// newRequestID genera un identificador corto para peticiones sin
+// X-Request-Id propio.
+func newRequestID() string {
+	var buf [12]byte
+	if _, err := rand.Read(buf[:]); err != nil {
+		return "unknown"
+	}
+	return hex.EncodeToString(buf[:])
+}
+
+// statusWriter envuelve http.ResponseWriter para capturar el status code y
+// los bytes escritos, que ResponseWriter no expone por sí mismo.
+type statusWriter struct {
+	http.ResponseWriter
+	status int
+	bytes  int
+}
+
+func (w *statusWriter) WriteHeader(status int) {
+	w.status = status
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *statusWriter) Write(b []byte) (int, error) {
+	n, err := w.ResponseWriter.Write(b)
+	w.bytes += n
+	return n, err
+}
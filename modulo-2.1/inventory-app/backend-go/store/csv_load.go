@@ -0,0 +1,232 @@
+package store
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"sync"
+)
+
+// Decision indica cómo continuar la carga tras un error de fila.
+type Decision int
+
+const (
+	// Skip descarta la fila y continúa con el resto.
+	Skip Decision = iota
+	// Abort detiene la carga inmediatamente.
+	Abort
+	// Retry reintenta decodificar la misma fila una vez más.
+	Retry
+)
+
+// LoadOptions controla la carga en LoadInventory.
+type LoadOptions struct {
+	// Workers es el número de goroutines que decodifican filas. Por
+	// defecto 4.
+	Workers int
+	// BufferSize es la capacidad del canal de filas pendientes. Por
+	// defecto 100.
+	BufferSize int
+	// StrictTypes hace que un valor numérico inválido sea un error de
+	// fila en lugar de decaer a cero.
+	StrictTypes bool
+	// OnError decide qué hacer ante un error de fila. Si es nil, cualquier
+	// error aborta la carga.
+	OnError func(row int, err error) Decision
+}
+
+// LoadInventory lee un CSV desde r con un único csv.Reader (sin reconstruir
+// strings ni re-invocar gocsv por fila) y reparte la decodificación entre
+// opts.Workers goroutines. Los errores de fila se agregan mediante
+// errors.Join en lugar de abortar la carga completa, salvo que OnError
+// decida Abort.
+func LoadInventory(ctx context.Context, r io.Reader, opts LoadOptions) ([]InventoryItem, error) {
+	workers := opts.Workers
+	if workers <= 0 {
+		workers = 4
+	}
+	bufferSize := opts.BufferSize
+	if bufferSize <= 0 {
+		bufferSize = 100
+	}
+	onError := opts.OnError
+	if onError == nil {
+		onError = func(row int, err error) Decision { return Abort }
+	}
+
+	csvReader := csv.NewReader(r)
+	header, err := csvReader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("error al leer la cabecera: %w", err)
+	}
+
+	bindings := bindColumns(header, reflect.TypeOf(InventoryItem{}))
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type record struct {
+		index  int
+		fields []string
+	}
+	records := make(chan record, bufferSize)
+
+	var (
+		mu    sync.Mutex
+		items []InventoryItem
+		errs  []error
+	)
+
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for rec := range records {
+				item, err := decodeRow(rec.fields, header, bindings, opts.StrictTypes)
+				if err == nil {
+					mu.Lock()
+					items = append(items, item)
+					mu.Unlock()
+					continue
+				}
+
+				decision := onError(rec.index, err)
+				if decision == Retry {
+					item, err = decodeRow(rec.fields, header, bindings, opts.StrictTypes)
+					if err == nil {
+						mu.Lock()
+						items = append(items, item)
+						mu.Unlock()
+						continue
+					}
+				}
+
+				mu.Lock()
+				errs = append(errs, fmt.Errorf("fila %d: %w", rec.index, err))
+				mu.Unlock()
+				if decision == Abort {
+					cancel()
+				}
+			}
+		}()
+	}
+
+	rowIndex := 0
+	var readErr error
+feed:
+	for {
+		row, err := csvReader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			readErr = fmt.Errorf("error al leer fila %d: %w", rowIndex+1, err)
+			break
+		}
+		rowIndex++
+
+		select {
+		case records <- record{index: rowIndex, fields: row}:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(records)
+	wg.Wait()
+
+	if readErr != nil {
+		errs = append(errs, readErr)
+	}
+	if len(errs) > 0 {
+		return items, errors.Join(errs...)
+	}
+	return items, nil
+}
+
+// rowErrorMessages descompone err (tal como lo agrega LoadInventory vía
+// errors.Join) en un mensaje por error individual. Un err nil devuelve nil.
+func rowErrorMessages(err error) []string {
+	if err == nil {
+		return nil
+	}
+	joined, ok := err.(interface{ Unwrap() []error })
+	if !ok {
+		return []string{err.Error()}
+	}
+	unwrapped := joined.Unwrap()
+	messages := make([]string, 0, len(unwrapped))
+	for _, e := range unwrapped {
+		messages = append(messages, e.Error())
+	}
+	return messages
+}
+
+// columnBinding asocia una columna del CSV (por posición) con un campo del
+// struct de destino.
+type columnBinding struct {
+	fieldIndex int
+	valid      bool
+}
+
+// bindColumns resuelve, para cada columna de header, el índice del campo de
+// t cuyo tag `csv` coincide.
+func bindColumns(header []string, t reflect.Type) []columnBinding {
+	fieldByTag := make(map[string]int, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		tag := t.Field(i).Tag.Get("csv")
+		if tag != "" {
+			fieldByTag[tag] = i
+		}
+	}
+
+	bindings := make([]columnBinding, len(header))
+	for i, col := range header {
+		if fieldIndex, ok := fieldByTag[col]; ok {
+			bindings[i] = columnBinding{fieldIndex: fieldIndex, valid: true}
+		}
+	}
+	return bindings
+}
+
+// decodeRow convierte una fila en un InventoryItem usando reflection, sin
+// volver a pasar por el parser de CSV.
+func decodeRow(fields []string, header []string, bindings []columnBinding, strict bool) (InventoryItem, error) {
+	var item InventoryItem
+	v := reflect.ValueOf(&item).Elem()
+
+	for i, raw := range fields {
+		if i >= len(bindings) || !bindings[i].valid {
+			continue
+		}
+		fv := v.Field(bindings[i].fieldIndex)
+
+		switch fv.Kind() {
+		case reflect.String:
+			fv.SetString(raw)
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			n, err := strconv.ParseInt(raw, 10, 64)
+			if err != nil {
+				if strict {
+					return InventoryItem{}, fmt.Errorf("columna %q: %w", header[i], err)
+				}
+				n = 0
+			}
+			fv.SetInt(n)
+		case reflect.Float32, reflect.Float64:
+			f, err := strconv.ParseFloat(raw, 64)
+			if err != nil {
+				if strict {
+					return InventoryItem{}, fmt.Errorf("columna %q: %w", header[i], err)
+				}
+				f = 0
+			}
+			fv.SetFloat(f)
+		}
+	}
+	return item, nil
+}
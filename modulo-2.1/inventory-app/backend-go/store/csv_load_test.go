@@ -0,0 +1,65 @@
+package store
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDecodeRowStrictRejectsInvalidNumbers(t *testing.T) {
+	header := []string{"id", "sku", "product_name", "category", "stock", "price", "last_updated"}
+	bindings := bindColumns(header, reflect.TypeOf(InventoryItem{}))
+
+	fields := []string{"1", "SKU-1", "Widget", "tools", "not-a-number", "9.99", "2026-01-01"}
+	if _, err := decodeRow(fields, header, bindings, true); err == nil {
+		t.Fatal("se esperaba un error con StrictTypes=true y stock inválido")
+	}
+}
+
+func TestDecodeRowNonStrictCoercesToZero(t *testing.T) {
+	header := []string{"id", "sku", "product_name", "category", "stock", "price", "last_updated"}
+	bindings := bindColumns(header, reflect.TypeOf(InventoryItem{}))
+
+	fields := []string{"1", "SKU-1", "Widget", "tools", "not-a-number", "9.99", "2026-01-01"}
+	item, err := decodeRow(fields, header, bindings, false)
+	if err != nil {
+		t.Fatalf("no se esperaba error con StrictTypes=false: %v", err)
+	}
+	if item.Stock != 0 {
+		t.Fatalf("stock = %d, se esperaba 0 tras coerción", item.Stock)
+	}
+	if item.Price != 9.99 {
+		t.Fatalf("price = %v, se esperaba 9.99", item.Price)
+	}
+}
+
+func TestLoadInventoryAggregatesRowErrors(t *testing.T) {
+	csvData := "id,sku,product_name,category,stock,price,last_updated\n" +
+		"1,SKU-1,Widget,tools,10,9.99,2026-01-01\n" +
+		"2,SKU-2,Gadget,tools,bad,19.99,2026-01-01\n" +
+		"3,SKU-3,Gizmo,tools,5,29.99,2026-01-01\n"
+
+	opts := LoadOptions{
+		StrictTypes: true,
+		OnError:     func(row int, err error) Decision { return Skip },
+	}
+
+	items, err := LoadInventory(context.Background(), strings.NewReader(csvData), opts)
+	if err == nil {
+		t.Fatal("se esperaba un error agregado por la fila inválida")
+	}
+	if len(items) != 2 {
+		t.Fatalf("items cargados = %d, se esperaban 2 (la fila inválida se descarta)", len(items))
+	}
+}
+
+func TestLoadInventoryAbortsOnFirstErrorByDefault(t *testing.T) {
+	csvData := "id,sku,product_name,category,stock,price,last_updated\n" +
+		"1,SKU-1,Widget,tools,bad,9.99,2026-01-01\n" +
+		"2,SKU-2,Gadget,tools,5,19.99,2026-01-01\n"
+
+	if _, err := LoadInventory(context.Background(), strings.NewReader(csvData), LoadOptions{StrictTypes: true}); err == nil {
+		t.Fatal("se esperaba un error: OnError nil aborta ante cualquier fila inválida")
+	}
+}
@@ -0,0 +1,213 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gocarina/gocsv"
+)
+
+// CSVStore guarda el inventario en un único archivo CSV. Cada Upsert/Delete
+// reescribe el archivo completo. Las lecturas (Get/List) no toman lock:
+// leen el índice vigente a través de un puntero atómico que Reload/Upsert/
+// Delete reemplazan por uno nuevo, de forma que un Reload en curso nunca
+// deja a un lector viendo un índice a medio construir.
+type CSVStore struct {
+	path string
+
+	idx     atomic.Pointer[index]
+	version atomic.Uint64
+
+	// writeMu serializa Upsert, Delete y Reload; los lectores no la usan.
+	writeMu sync.Mutex
+
+	bc *broadcaster
+}
+
+// NewCSVStore carga path (si existe) y devuelve un store listo para usar.
+// Cualquier fila inválida aborta la carga, igual que hacía el
+// loadInventoryParallel original; quien necesite tolerancia a errores o
+// control fino de memoria debe llamar a LoadInventory directamente.
+func NewCSVStore(path string) (*CSVStore, error) {
+	s := &CSVStore{
+		path: path,
+		bc:   newBroadcaster(),
+	}
+
+	items, err := loadCSVFile(path)
+	if err != nil {
+		return nil, err
+	}
+	s.idx.Store(buildIndex(items))
+	return s, nil
+}
+
+// loadCSVFile lee path completo y lo convierte en un mapa por ID.
+func loadCSVFile(path string) (map[string]InventoryItem, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("error al abrir el archivo: %w", err)
+	}
+	defer file.Close()
+
+	loaded, err := LoadInventory(context.Background(), file, LoadOptions{StrictTypes: true})
+	if err != nil {
+		return nil, err
+	}
+
+	items := make(map[string]InventoryItem, len(loaded))
+	for _, item := range loaded {
+		items[item.ID] = item
+	}
+	return items, nil
+}
+
+// loadCSVFileTolerant relee path igual que loadCSVFile, pero con
+// StrictTypes:false y un OnError que descarta la fila en lugar de abortar.
+// Los mensajes de las filas descartadas se devuelven en rowErrors; err solo
+// se usa para errores ajenos al contenido del archivo (p. ej. no poder
+// abrirlo).
+func loadCSVFileTolerant(path string) (items map[string]InventoryItem, rowErrors []string, err error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error al abrir el archivo: %w", err)
+	}
+	defer file.Close()
+
+	loaded, loadErr := LoadInventory(context.Background(), file, LoadOptions{
+		OnError: func(row int, err error) Decision { return Skip },
+	})
+
+	items = make(map[string]InventoryItem, len(loaded))
+	for _, item := range loaded {
+		items[item.ID] = item
+	}
+	return items, rowErrorMessages(loadErr), nil
+}
+
+func (s *CSVStore) Get(ctx context.Context, id string) (InventoryItem, error) {
+	item, ok := s.idx.Load().items[id]
+	if !ok {
+		return InventoryItem{}, ErrNotFound
+	}
+	return item, nil
+}
+
+func (s *CSVStore) List(ctx context.Context, filter Filter) ([]InventoryItem, error) {
+	candidates := s.idx.Load().candidates(filter)
+	result := make([]InventoryItem, 0, len(candidates))
+	for _, item := range candidates {
+		if filter.matches(item) {
+			result = append(result, item)
+		}
+	}
+	return result, nil
+}
+
+func (s *CSVStore) Upsert(ctx context.Context, item InventoryItem) error {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	next := cloneItems(s.idx.Load().items)
+	next[item.ID] = item
+	if err := persistCSV(s.path, next); err != nil {
+		return err
+	}
+	s.idx.Store(buildIndex(next))
+	s.version.Add(1)
+	s.bc.emit(Event{Type: EventUpsert, Item: item})
+	return nil
+}
+
+func (s *CSVStore) Delete(ctx context.Context, id string) error {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	current := s.idx.Load().items
+	item, ok := current[id]
+	if !ok {
+		return ErrNotFound
+	}
+
+	next := cloneItems(current)
+	delete(next, id)
+	if err := persistCSV(s.path, next); err != nil {
+		return err
+	}
+	s.idx.Store(buildIndex(next))
+	s.version.Add(1)
+	s.bc.emit(Event{Type: EventDelete, Item: item})
+	return nil
+}
+
+// Reload vuelve a leer s.path desde disco y reemplaza el inventario en
+// memoria de forma atómica. Satisface store.Reloadable. A diferencia de la
+// carga inicial, tolera filas inválidas: las descarta y las reporta en
+// ReloadSummary.Errors en lugar de abortar el reload completo, para que un
+// único valor corrupto en un archivo editado a mano no tire todo el
+// inventario en memoria.
+func (s *CSVStore) Reload(ctx context.Context) (ReloadSummary, error) {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	start := time.Now()
+	next, rowErrors, err := loadCSVFileTolerant(s.path)
+	if err != nil {
+		return ReloadSummary{}, err
+	}
+	s.idx.Store(buildIndex(next))
+	s.version.Add(1)
+
+	return ReloadSummary{
+		Loaded:     len(next),
+		DurationMS: time.Since(start).Milliseconds(),
+		Errors:     rowErrors,
+	}, nil
+}
+
+// Version satisface store.Versioned.
+func (s *CSVStore) Version() uint64 {
+	return s.version.Load()
+}
+
+// cloneItems devuelve una copia superficial de items, para que Upsert/Delete
+// nunca muten el mapa que los lectores puedan tener en mano.
+func cloneItems(items map[string]InventoryItem) map[string]InventoryItem {
+	next := make(map[string]InventoryItem, len(items)+1)
+	for id, item := range items {
+		next[id] = item
+	}
+	return next
+}
+
+// persistCSV reescribe el archivo CSV completo con items.
+func persistCSV(path string, items map[string]InventoryItem) error {
+	list := make([]InventoryItem, 0, len(items))
+	for _, item := range items {
+		list = append(list, item)
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("error al escribir el archivo: %w", err)
+	}
+	defer file.Close()
+
+	if err := gocsv.MarshalFile(&list, file); err != nil {
+		return fmt.Errorf("error al serializar a CSV: %w", err)
+	}
+	return nil
+}
+
+// Watch satisface store.InventoryStore.
+func (s *CSVStore) Watch(ctx context.Context) <-chan Event {
+	return s.bc.Watch(ctx)
+}
+
+func (s *CSVStore) Close() error {
+	return nil
+}
@@ -0,0 +1,221 @@
+package store
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// JSONLStore guarda el inventario en un archivo de JSON Lines (un objeto
+// InventoryItem por línea). Igual que CSVStore, los lectores no toman lock:
+// leen el índice vigente a través de un puntero atómico.
+type JSONLStore struct {
+	path string
+
+	idx     atomic.Pointer[index]
+	version atomic.Uint64
+
+	// writeMu serializa Upsert, Delete y Reload; los lectores no la usan.
+	writeMu sync.Mutex
+
+	bc *broadcaster
+}
+
+// NewJSONLStore carga path (si existe) y devuelve un store listo para usar.
+func NewJSONLStore(path string) (*JSONLStore, error) {
+	s := &JSONLStore{
+		path: path,
+		bc:   newBroadcaster(),
+	}
+
+	items, err := loadJSONLFile(path)
+	if err != nil {
+		return nil, err
+	}
+	s.idx.Store(buildIndex(items))
+	return s, nil
+}
+
+// loadJSONLFile lee path completo y lo convierte en un mapa por ID. Un
+// archivo inexistente se trata como inventario vacío.
+func loadJSONLFile(path string) (map[string]InventoryItem, error) {
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return make(map[string]InventoryItem), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error al abrir el archivo: %w", err)
+	}
+	defer file.Close()
+
+	items := make(map[string]InventoryItem)
+	scanner := bufio.NewScanner(file)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+		var item InventoryItem
+		if err := json.Unmarshal(line, &item); err != nil {
+			return nil, fmt.Errorf("error al parsear línea %d: %w", lineNum, err)
+		}
+		items[item.ID] = item
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("error al leer el archivo: %w", err)
+	}
+	return items, nil
+}
+
+// loadJSONLFileTolerant relee path igual que loadJSONLFile, pero descarta
+// las líneas que no decodifican en lugar de abortar la carga: cada una se
+// agrega a rowErrors. err solo se usa para errores ajenos al contenido del
+// archivo (p. ej. no poder abrirlo o leerlo).
+func loadJSONLFileTolerant(path string) (items map[string]InventoryItem, rowErrors []string, err error) {
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return make(map[string]InventoryItem), nil, nil
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("error al abrir el archivo: %w", err)
+	}
+	defer file.Close()
+
+	items = make(map[string]InventoryItem)
+	scanner := bufio.NewScanner(file)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+		var item InventoryItem
+		if err := json.Unmarshal(line, &item); err != nil {
+			rowErrors = append(rowErrors, fmt.Sprintf("línea %d: %v", lineNum, err))
+			continue
+		}
+		items[item.ID] = item
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, nil, fmt.Errorf("error al leer el archivo: %w", err)
+	}
+	return items, rowErrors, nil
+}
+
+func (s *JSONLStore) Get(ctx context.Context, id string) (InventoryItem, error) {
+	item, ok := s.idx.Load().items[id]
+	if !ok {
+		return InventoryItem{}, ErrNotFound
+	}
+	return item, nil
+}
+
+func (s *JSONLStore) List(ctx context.Context, filter Filter) ([]InventoryItem, error) {
+	candidates := s.idx.Load().candidates(filter)
+	result := make([]InventoryItem, 0, len(candidates))
+	for _, item := range candidates {
+		if filter.matches(item) {
+			result = append(result, item)
+		}
+	}
+	return result, nil
+}
+
+func (s *JSONLStore) Upsert(ctx context.Context, item InventoryItem) error {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	next := cloneItems(s.idx.Load().items)
+	next[item.ID] = item
+	if err := persistJSONL(s.path, next); err != nil {
+		return err
+	}
+	s.idx.Store(buildIndex(next))
+	s.version.Add(1)
+	s.bc.emit(Event{Type: EventUpsert, Item: item})
+	return nil
+}
+
+func (s *JSONLStore) Delete(ctx context.Context, id string) error {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	current := s.idx.Load().items
+	item, ok := current[id]
+	if !ok {
+		return ErrNotFound
+	}
+
+	next := cloneItems(current)
+	delete(next, id)
+	if err := persistJSONL(s.path, next); err != nil {
+		return err
+	}
+	s.idx.Store(buildIndex(next))
+	s.version.Add(1)
+	s.bc.emit(Event{Type: EventDelete, Item: item})
+	return nil
+}
+
+// Reload vuelve a leer s.path desde disco y reemplaza el inventario en
+// memoria de forma atómica. Satisface store.Reloadable. A diferencia de la
+// carga inicial, tolera líneas inválidas: las descarta y las reporta en
+// ReloadSummary.Errors en lugar de abortar el reload completo.
+func (s *JSONLStore) Reload(ctx context.Context) (ReloadSummary, error) {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	start := time.Now()
+	next, rowErrors, err := loadJSONLFileTolerant(s.path)
+	if err != nil {
+		return ReloadSummary{}, err
+	}
+	s.idx.Store(buildIndex(next))
+	s.version.Add(1)
+
+	return ReloadSummary{
+		Loaded:     len(next),
+		DurationMS: time.Since(start).Milliseconds(),
+		Errors:     rowErrors,
+	}, nil
+}
+
+// Version satisface store.Versioned.
+func (s *JSONLStore) Version() uint64 {
+	return s.version.Load()
+}
+
+// persistJSONL reescribe el archivo completo, una línea JSON por item.
+func persistJSONL(path string, items map[string]InventoryItem) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("error al escribir el archivo: %w", err)
+	}
+	defer file.Close()
+
+	w := bufio.NewWriter(file)
+	enc := json.NewEncoder(w)
+	for _, item := range items {
+		if err := enc.Encode(item); err != nil {
+			return fmt.Errorf("error al serializar item %q: %w", item.ID, err)
+		}
+	}
+	return w.Flush()
+}
+
+// Watch satisface store.InventoryStore.
+func (s *JSONLStore) Watch(ctx context.Context) <-chan Event {
+	return s.bc.Watch(ctx)
+}
+
+func (s *JSONLStore) Close() error {
+	return nil
+}
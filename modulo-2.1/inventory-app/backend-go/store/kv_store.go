@@ -0,0 +1,160 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.etcd.io/bbolt"
+)
+
+var inventoryBucket = []byte("inventory")
+
+// KVStore guarda el inventario en una base de datos embebida (bbolt). Cada
+// item se persiste como un registro JSON bajo su ID, pero igual que
+// CSVStore/JSONLStore, Get/List se resuelven contra un índice en memoria
+// (con sus mapas secundarios por categoría y SKU) para no recorrer el bucket
+// completo en cada consulta filtrada.
+type KVStore struct {
+	db *bbolt.DB
+
+	idx     atomic.Pointer[index]
+	version atomic.Uint64
+
+	// writeMu serializa Upsert y Delete; los lectores no la usan.
+	writeMu sync.Mutex
+
+	bc *broadcaster
+}
+
+// NewKVStore abre (o crea) la base de datos en path.
+func NewKVStore(path string) (*KVStore, error) {
+	db, err := bbolt.Open(path, 0o600, &bbolt.Options{Timeout: 2 * time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("error al abrir la base KV: %w", err)
+	}
+
+	err = db.Update(func(tx *bbolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(inventoryBucket)
+		return err
+	})
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("error al inicializar el bucket: %w", err)
+	}
+
+	items, err := loadKVItems(db)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	s := &KVStore{db: db, bc: newBroadcaster()}
+	s.idx.Store(buildIndex(items))
+	return s, nil
+}
+
+// loadKVItems recorre el bucket completo y lo convierte en un mapa por ID,
+// para construir el índice en memoria al abrir la base o tras una escritura.
+func loadKVItems(db *bbolt.DB) (map[string]InventoryItem, error) {
+	items := make(map[string]InventoryItem)
+	err := db.View(func(tx *bbolt.Tx) error {
+		return tx.Bucket(inventoryBucket).ForEach(func(_, raw []byte) error {
+			var item InventoryItem
+			if err := json.Unmarshal(raw, &item); err != nil {
+				return err
+			}
+			items[item.ID] = item
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+func (s *KVStore) Get(ctx context.Context, id string) (InventoryItem, error) {
+	item, ok := s.idx.Load().items[id]
+	if !ok {
+		return InventoryItem{}, ErrNotFound
+	}
+	return item, nil
+}
+
+func (s *KVStore) List(ctx context.Context, filter Filter) ([]InventoryItem, error) {
+	candidates := s.idx.Load().candidates(filter)
+	result := make([]InventoryItem, 0, len(candidates))
+	for _, item := range candidates {
+		if filter.matches(item) {
+			result = append(result, item)
+		}
+	}
+	return result, nil
+}
+
+func (s *KVStore) Upsert(ctx context.Context, item InventoryItem) error {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	raw, err := json.Marshal(item)
+	if err != nil {
+		return fmt.Errorf("error al serializar item %q: %w", item.ID, err)
+	}
+
+	err = s.db.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket(inventoryBucket).Put([]byte(item.ID), raw)
+	})
+	if err != nil {
+		return err
+	}
+
+	next := cloneItems(s.idx.Load().items)
+	next[item.ID] = item
+	s.idx.Store(buildIndex(next))
+	s.version.Add(1)
+	s.bc.emit(Event{Type: EventUpsert, Item: item})
+	return nil
+}
+
+func (s *KVStore) Delete(ctx context.Context, id string) error {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	current := s.idx.Load().items
+	item, ok := current[id]
+	if !ok {
+		return ErrNotFound
+	}
+
+	err := s.db.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket(inventoryBucket).Delete([]byte(id))
+	})
+	if err != nil {
+		return err
+	}
+
+	next := cloneItems(current)
+	delete(next, id)
+	s.idx.Store(buildIndex(next))
+	s.version.Add(1)
+	s.bc.emit(Event{Type: EventDelete, Item: item})
+	return nil
+}
+
+// Version satisface store.Versioned.
+func (s *KVStore) Version() uint64 {
+	return s.version.Load()
+}
+
+// Watch satisface store.InventoryStore.
+func (s *KVStore) Watch(ctx context.Context) <-chan Event {
+	return s.bc.Watch(ctx)
+}
+
+func (s *KVStore) Close() error {
+	return s.db.Close()
+}
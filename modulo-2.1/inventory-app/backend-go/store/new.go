@@ -0,0 +1,18 @@
+package store
+
+import "fmt"
+
+// New construye el InventoryStore indicado por kind ("csv", "jsonl" o "kv"),
+// persistiendo en path.
+func New(kind, path string) (InventoryStore, error) {
+	switch kind {
+	case "", "csv":
+		return NewCSVStore(path)
+	case "jsonl":
+		return NewJSONLStore(path)
+	case "kv", "bolt", "bbolt":
+		return NewKVStore(path)
+	default:
+		return nil, fmt.Errorf("store: backend desconocido %q", kind)
+	}
+}
@@ -0,0 +1,145 @@
+package store
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCSVStorePersistsAcrossReload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "inventory.csv")
+	if err := os.WriteFile(path, []byte("id,sku,product_name,category,stock,price,last_updated\n"), 0o644); err != nil {
+		t.Fatalf("WriteFile inicial: %v", err)
+	}
+
+	s, err := NewCSVStore(path)
+	if err != nil {
+		t.Fatalf("NewCSVStore: %v", err)
+	}
+	defer s.Close()
+
+	item := InventoryItem{ID: "1", SKU: "SKU-1", ProductName: "Widget", Category: "tools", Stock: 10, Price: 9.99, LastUpdated: "2026-01-01"}
+	if err := s.Upsert(context.Background(), item); err != nil {
+		t.Fatalf("Upsert: %v", err)
+	}
+
+	reloaded, err := NewCSVStore(path)
+	if err != nil {
+		t.Fatalf("NewCSVStore tras Upsert: %v", err)
+	}
+	defer reloaded.Close()
+
+	got, err := reloaded.Get(context.Background(), "1")
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if got != item {
+		t.Fatalf("Get() = %+v, se esperaba %+v", got, item)
+	}
+
+	if err := s.Delete(context.Background(), "1"); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	summary, err := reloaded.Reload(context.Background())
+	if err != nil {
+		t.Fatalf("Reload: %v", err)
+	}
+	if summary.Loaded != 0 {
+		t.Fatalf("Loaded = %d tras Delete+Reload, se esperaba 0", summary.Loaded)
+	}
+	if _, err := reloaded.Get(context.Background(), "1"); err != ErrNotFound {
+		t.Fatalf("Get tras Reload = %v, se esperaba ErrNotFound", err)
+	}
+}
+
+func TestJSONLStorePersistsAcrossReload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "inventory.jsonl")
+
+	s, err := NewJSONLStore(path)
+	if err != nil {
+		t.Fatalf("NewJSONLStore: %v", err)
+	}
+	defer s.Close()
+
+	item := InventoryItem{ID: "1", SKU: "SKU-1", ProductName: "Widget", Category: "tools", Stock: 10, Price: 9.99, LastUpdated: "2026-01-01"}
+	if err := s.Upsert(context.Background(), item); err != nil {
+		t.Fatalf("Upsert: %v", err)
+	}
+
+	reloaded, err := NewJSONLStore(path)
+	if err != nil {
+		t.Fatalf("NewJSONLStore tras Upsert: %v", err)
+	}
+	defer reloaded.Close()
+
+	got, err := reloaded.Get(context.Background(), "1")
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if got != item {
+		t.Fatalf("Get() = %+v, se esperaba %+v", got, item)
+	}
+
+	if err := s.Delete(context.Background(), "1"); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	summary, err := reloaded.Reload(context.Background())
+	if err != nil {
+		t.Fatalf("Reload: %v", err)
+	}
+	if summary.Loaded != 0 {
+		t.Fatalf("Loaded = %d tras Delete+Reload, se esperaba 0", summary.Loaded)
+	}
+	if _, err := reloaded.Get(context.Background(), "1"); err != ErrNotFound {
+		t.Fatalf("Get tras Reload = %v, se esperaba ErrNotFound", err)
+	}
+}
+
+func TestKVStorePersistsAcrossReopen(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "inventory.db")
+
+	s, err := NewKVStore(path)
+	if err != nil {
+		t.Fatalf("NewKVStore: %v", err)
+	}
+
+	item := InventoryItem{ID: "1", SKU: "SKU-1", ProductName: "Widget", Category: "tools", Stock: 10, Price: 9.99, LastUpdated: "2026-01-01"}
+	if err := s.Upsert(context.Background(), item); err != nil {
+		t.Fatalf("Upsert: %v", err)
+	}
+	if err := s.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	reopened, err := NewKVStore(path)
+	if err != nil {
+		t.Fatalf("NewKVStore tras reabrir: %v", err)
+	}
+	defer reopened.Close()
+
+	got, err := reopened.Get(context.Background(), "1")
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if got != item {
+		t.Fatalf("Get() = %+v, se esperaba %+v", got, item)
+	}
+
+	if err := reopened.Delete(context.Background(), "1"); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if err := reopened.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	final, err := NewKVStore(path)
+	if err != nil {
+		t.Fatalf("NewKVStore tras Delete: %v", err)
+	}
+	defer final.Close()
+
+	if _, err := final.Get(context.Background(), "1"); err != ErrNotFound {
+		t.Fatalf("Get tras Delete+reabrir = %v, se esperaba ErrNotFound", err)
+	}
+}
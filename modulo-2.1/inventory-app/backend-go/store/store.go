@@ -0,0 +1,162 @@
+// Package store define el contrato de persistencia del inventario y sus
+// distintas implementaciones (CSV, JSON lines, KV embebido).
+package store
+
+import (
+	"context"
+	"errors"
+	"strings"
+)
+
+// ErrNotFound se devuelve cuando un item no existe en el store.
+var ErrNotFound = errors.New("store: item no encontrado")
+
+// InventoryItem representa un item del inventario.
+type InventoryItem struct {
+	ID          string  `csv:"id" json:"id"`
+	SKU         string  `csv:"sku" json:"sku"`
+	ProductName string  `csv:"product_name" json:"product_name"`
+	Category    string  `csv:"category" json:"category"`
+	Stock       int     `csv:"stock" json:"stock"`
+	Price       float64 `csv:"price" json:"price"`
+	LastUpdated string  `csv:"last_updated" json:"last_updated"`
+}
+
+// Filter restringe los resultados devueltos por List. Los campos vacíos (o
+// nil, para los punteros) se ignoran.
+type Filter struct {
+	Category string
+	SKU      string
+	MinStock *int
+	MaxPrice *float64
+	// Query filtra por substring (case-insensitive) de ProductName.
+	Query string
+}
+
+// EventType distingue los tipos de cambio que puede emitir Watch.
+type EventType string
+
+const (
+	EventUpsert EventType = "upsert"
+	EventDelete EventType = "delete"
+)
+
+// Event representa un cambio en el inventario emitido por Watch.
+type Event struct {
+	Type EventType
+	Item InventoryItem
+}
+
+// InventoryStore es el contrato que deben implementar los distintos backends
+// de persistencia del inventario.
+type InventoryStore interface {
+	// Get devuelve el item con el id dado, o ErrNotFound si no existe.
+	Get(ctx context.Context, id string) (InventoryItem, error)
+	// List devuelve los items que cumplen filter. Un filter vacío devuelve
+	// todo el inventario.
+	List(ctx context.Context, filter Filter) ([]InventoryItem, error)
+	// Upsert crea o reemplaza el item indicado.
+	Upsert(ctx context.Context, item InventoryItem) error
+	// Delete elimina el item con el id dado. No es un error borrar un item
+	// inexistente.
+	Delete(ctx context.Context, id string) error
+	// Watch devuelve un canal con los cambios del inventario. El canal se
+	// cierra cuando ctx se cancela. Ningún endpoint lo consume todavía; se
+	// deja en el contrato porque el pedido original lo requiere, a la
+	// espera de una ruta de live-updates (SSE/websocket).
+	Watch(ctx context.Context) <-chan Event
+	// Close libera los recursos del store (archivos, conexiones, etc).
+	Close() error
+}
+
+// ReloadSummary describe el resultado de una recarga disparada por
+// Reloadable.Reload.
+type ReloadSummary struct {
+	Loaded     int      `json:"loaded"`
+	DurationMS int64    `json:"duration_ms"`
+	Errors     []string `json:"errors,omitempty"`
+}
+
+// Reloadable lo implementan los stores respaldados por un archivo externo
+// que puede recargarse en caliente (CSV, JSON lines). Los backends sin
+// fuente externa propia, como KVStore, no lo implementan.
+type Reloadable interface {
+	Reload(ctx context.Context) (ReloadSummary, error)
+}
+
+// matches indica si item cumple con filter.
+func (f Filter) matches(item InventoryItem) bool {
+	if f.Category != "" && item.Category != f.Category {
+		return false
+	}
+	if f.SKU != "" && item.SKU != f.SKU {
+		return false
+	}
+	if f.MinStock != nil && item.Stock < *f.MinStock {
+		return false
+	}
+	if f.MaxPrice != nil && item.Price > *f.MaxPrice {
+		return false
+	}
+	if f.Query != "" && !strings.Contains(strings.ToLower(item.ProductName), strings.ToLower(f.Query)) {
+		return false
+	}
+	return true
+}
+
+// Versioned lo implementan los stores que llevan un contador de versión,
+// incrementado en cada Upsert/Delete/Reload. Sirve para construir ETags
+// baratos sin tener que volver a serializar el inventario.
+type Versioned interface {
+	Version() uint64
+}
+
+// index es la estructura compartida por CSVStore y JSONLStore: el mapa de
+// items más los índices secundarios que evitan recorrer todo el inventario
+// en cada List filtrado.
+type index struct {
+	items      map[string]InventoryItem
+	byCategory map[string][]string
+	bySKU      map[string]string
+}
+
+// buildIndex construye los índices secundarios a partir de items.
+func buildIndex(items map[string]InventoryItem) *index {
+	idx := &index{
+		items:      items,
+		byCategory: make(map[string][]string),
+		bySKU:      make(map[string]string, len(items)),
+	}
+	for id, item := range items {
+		idx.byCategory[item.Category] = append(idx.byCategory[item.Category], id)
+		idx.bySKU[item.SKU] = id
+	}
+	return idx
+}
+
+// candidates devuelve, usando los índices cuando es posible, el conjunto de
+// items a evaluar contra filter. Si no hay un índice aplicable, devuelve
+// todo el inventario.
+func (idx *index) candidates(filter Filter) []InventoryItem {
+	switch {
+	case filter.SKU != "":
+		id, ok := idx.bySKU[filter.SKU]
+		if !ok {
+			return nil
+		}
+		return []InventoryItem{idx.items[id]}
+	case filter.Category != "":
+		ids := idx.byCategory[filter.Category]
+		result := make([]InventoryItem, 0, len(ids))
+		for _, id := range ids {
+			result = append(result, idx.items[id])
+		}
+		return result
+	default:
+		result := make([]InventoryItem, 0, len(idx.items))
+		for _, item := range idx.items {
+			result = append(result, item)
+		}
+		return result
+	}
+}
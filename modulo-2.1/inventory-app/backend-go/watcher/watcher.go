@@ -0,0 +1,116 @@
+// Package watcher recarga el inventario en caliente cuando su archivo fuente
+// cambia en disco, o cuando el proceso recibe SIGHUP.
+package watcher
+
+import (
+	"context"
+	"log"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+
+	"github.com/cTapiaDev/ai-programming-course/modulo-2.1/inventory-app/backend-go/metrics"
+	"github.com/cTapiaDev/ai-programming-course/modulo-2.1/inventory-app/backend-go/store"
+)
+
+// defaultDebounce agrupa ráfagas de "save" del editor en una sola recarga.
+const defaultDebounce = 300 * time.Millisecond
+
+// Watcher vigila un archivo y dispara Reload sobre un store.Reloadable.
+type Watcher struct {
+	path     string
+	reloader store.Reloadable
+	debounce time.Duration
+
+	fsw *fsnotify.Watcher
+}
+
+// New crea un Watcher para path. No empieza a vigilar hasta llamar a Run.
+func New(path string, reloader store.Reloadable) (*Watcher, error) {
+	fsw, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+
+	// Se vigila el directorio, no el archivo: muchos editores guardan
+	// escribiendo un archivo temporal y renombrándolo encima del original,
+	// lo que no dispara un evento Write sobre el inode original.
+	dir := filepath.Dir(path)
+	if err := fsw.Add(dir); err != nil {
+		fsw.Close()
+		return nil, err
+	}
+
+	return &Watcher{
+		path:     path,
+		reloader: reloader,
+		debounce: defaultDebounce,
+		fsw:      fsw,
+	}, nil
+}
+
+// Run procesa eventos hasta que ctx se cancela. Se espera que se llame en su
+// propia goroutine.
+func (w *Watcher) Run(ctx context.Context) {
+	defer w.fsw.Close()
+
+	sighup := make(chan os.Signal, 1)
+	signal.Notify(sighup, syscall.SIGHUP)
+	defer signal.Stop(sighup)
+
+	pending := make(chan struct{}, 1)
+	requestReload := func() {
+		select {
+		case pending <- struct{}{}:
+		default:
+		}
+	}
+
+	var timer *time.Timer
+	target := filepath.Clean(w.path)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case event, ok := <-w.fsw.Events:
+			if !ok {
+				return
+			}
+			if filepath.Clean(event.Name) != target {
+				continue
+			}
+			if event.Op&(fsnotify.Write|fsnotify.Create|fsnotify.Rename) == 0 {
+				continue
+			}
+			if timer == nil {
+				timer = time.AfterFunc(w.debounce, requestReload)
+			} else {
+				timer.Reset(w.debounce)
+			}
+
+		case err, ok := <-w.fsw.Errors:
+			if !ok {
+				return
+			}
+			log.Printf("watcher: error al vigilar %s: %v\n", w.path, err)
+
+		case <-sighup:
+			requestReload()
+
+		case <-pending:
+			summary, err := w.reloader.Reload(ctx)
+			metrics.RecordReload(float64(summary.DurationMS)/1000, summary.Loaded, err)
+			if err != nil {
+				log.Printf("watcher: error al recargar %s: %v\n", w.path, err)
+				continue
+			}
+			log.Printf("watcher: inventario recargado desde %s (%d items, %dms)\n", w.path, summary.Loaded, summary.DurationMS)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package watcher
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cTapiaDev/ai-programming-course/modulo-2.1/inventory-app/backend-go/store"
+)
+
+// countingReloader cuenta cuántas veces se llama a Reload, para verificar
+// que varios eventos en ráfaga se colapsan en una sola recarga.
+type countingReloader struct {
+	calls atomic.Int32
+}
+
+func (r *countingReloader) Reload(ctx context.Context) (store.ReloadSummary, error) {
+	r.calls.Add(1)
+	return store.ReloadSummary{Loaded: 1}, nil
+}
+
+func TestWatcherDebouncesBurstOfEvents(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "inventory.csv")
+	if err := os.WriteFile(path, []byte("inicial"), 0o644); err != nil {
+		t.Fatalf("WriteFile inicial: %v", err)
+	}
+
+	reloader := &countingReloader{}
+	w, err := New(path, reloader)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	w.debounce = 20 * time.Millisecond
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go w.Run(ctx)
+
+	for i := 0; i < 5; i++ {
+		if err := os.WriteFile(path, []byte("cambio"), 0o644); err != nil {
+			t.Fatalf("WriteFile cambio: %v", err)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	time.Sleep(200 * time.Millisecond)
+
+	if got := reloader.calls.Load(); got != 1 {
+		t.Fatalf("Reload se llamó %d veces, se esperaba 1 para una ráfaga de escrituras", got)
+	}
+}